@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	RegisterParser(reflect.TypeOf(LimiterStrategy("")), func(raw string) (reflect.Value, error) {
+		return reflect.ValueOf(LimiterStrategy(raw)), nil
+	})
+}
+
+// LimiterStrategy selects how the limits in LimitsConfig get enforced
+// across a fleet of gateway replicas.
+type LimiterStrategy string
+
+const (
+	// LimiterStrategyLocal has each gateway instance enforce limit/N,
+	// where N is the current healthy replica count discovered from the
+	// ring/registry. Cheap, no extra hop, but only bounds the
+	// per-replica rate, not the cluster-wide one.
+	LimiterStrategyLocal LimiterStrategy = "local"
+	// LimiterStrategyGlobal consults a Redis-backed token bucket keyed
+	// on "tenant:resource" so the aggregate cluster-wide rate is bounded
+	// even under uneven request distribution across replicas.
+	LimiterStrategyGlobal LimiterStrategy = "global"
+)
+
+// LimitsConfig configures per-tenant caps for the scheduler: a
+// cluster-wide default, how those caps are enforced across replicas, and
+// where to find per-tenant overrides.
+type LimitsConfig struct {
+	MaxInflightRequests int             `yaml:"max_inflight_requests" env:"LIMITS_MAX_INFLIGHT_REQUESTS" default:"0"` // 0 = unlimited
+	MaxTokensPerSecond  int             `yaml:"max_tokens_per_second" env:"LIMITS_MAX_TOKENS_PER_SECOND" default:"0"` // 0 = unlimited
+	MaxBatchSize        int             `yaml:"limits_max_batch_size" env:"LIMITS_MAX_BATCH_SIZE" default:"0"`        // 0 = unlimited, falls back to SchedulerConfig.MaxBatchSize
+	TierCeiling         int             `yaml:"tier_ceiling" env:"LIMITS_TIER_CEILING" default:"3"`                   // highest (lowest-priority) tier a tenant may request
+	LimiterStrategy     LimiterStrategy `yaml:"limiter_strategy" env:"LIMITS_LIMITER_STRATEGY" default:"local"`       // "local" | "global"
+	OverridesFile       string          `yaml:"limits_overrides_file" env:"LIMITS_OVERRIDES_FILE" default:""`         // YAML file of per-tenant overrides, reloaded on SIGHUP
+}
+
+// Default returns the cluster-wide limits as a TenantLimits, used as the
+// fallback for any tenant with no entry in the overrides file.
+// schedulerMaxBatchSize is the owning SchedulerConfig.MaxBatchSize; it is
+// used in place of l.MaxBatchSize when the latter is 0, since a tenant cap
+// of "unlimited" should still be bounded by the scheduler's own batch size.
+func (l LimitsConfig) Default(schedulerMaxBatchSize int) TenantLimits {
+	maxBatchSize := l.MaxBatchSize
+	if maxBatchSize == 0 {
+		maxBatchSize = schedulerMaxBatchSize
+	}
+	return TenantLimits{
+		MaxInflightRequests: l.MaxInflightRequests,
+		MaxTokensPerSecond:  l.MaxTokensPerSecond,
+		MaxBatchSize:        maxBatchSize,
+		TierCeiling:         l.TierCeiling,
+	}
+}
+
+// TenantLimits caps how much of the scheduler a single tenant may
+// consume. The zero value of any field means "no cap".
+type TenantLimits struct {
+	MaxInflightRequests int `yaml:"max_inflight_requests"`
+	MaxTokensPerSecond  int `yaml:"max_tokens_per_second"`
+	MaxBatchSize        int `yaml:"max_batch_size"`
+	TierCeiling         int `yaml:"tier_ceiling"` // highest (lowest-priority) tier this tenant may request
+}
+
+// TenantOverrides holds per-tenant limit overrides loaded from a
+// LimitsConfig.OverridesFile, falling back to a cluster-wide default for
+// any tenant without an explicit entry.
+type TenantOverrides struct {
+	def       TenantLimits
+	overrides map[string]TenantLimits
+}
+
+// LoadTenantOverrides reads path (if non-empty) as a YAML document
+// mapping tenant ID to TenantLimits, e.g.:
+//
+//	acme-corp:
+//	  max_inflight_requests: 200
+//	  tier_ceiling: 1
+//
+// A tenant absent from the file gets def.
+func LoadTenantOverrides(path string, def TenantLimits) (*TenantOverrides, error) {
+	overrides := map[string]TenantLimits{}
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("config: reading limits overrides file %q: %w", path, err)
+		}
+		if err := yaml.Unmarshal(raw, &overrides); err != nil {
+			return nil, fmt.Errorf("config: parsing limits overrides file %q: %w", path, err)
+		}
+	}
+	return &TenantOverrides{def: def, overrides: overrides}, nil
+}
+
+// Limits returns the effective TenantLimits for tenant, falling back to
+// the cluster-wide default when there is no override on file.
+func (t *TenantOverrides) Limits(tenant string) TenantLimits {
+	if l, ok := t.overrides[tenant]; ok {
+		return l
+	}
+	return t.def
+}
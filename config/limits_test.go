@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLimitsMaxBatchSizeNoCollision checks that the scheduler's own
+// max_batch_size and the per-tenant limits_max_batch_size are independently
+// configurable via a file, since both once shared the tag "max_batch_size".
+func TestLimitsMaxBatchSizeNoCollision(t *testing.T) {
+	resetConfigFilePath(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "goinfer.yaml")
+	if err := os.WriteFile(path, []byte("max_batch_size: 42\n"), 0o644); err != nil {
+		t.Fatalf("writing temp config file: %v", err)
+	}
+	SetConfigFilePath(path)
+
+	cfg := Load()
+	if cfg.Scheduler.MaxBatchSize != 42 {
+		t.Errorf("Scheduler.MaxBatchSize = %d, want 42", cfg.Scheduler.MaxBatchSize)
+	}
+	if cfg.Scheduler.Limits.MaxBatchSize != 0 {
+		t.Errorf("Limits.MaxBatchSize = %d, want untouched default 0", cfg.Scheduler.Limits.MaxBatchSize)
+	}
+}
+
+// TestLimitsConfigDefaultFallsBackToSchedulerMaxBatchSize checks that a
+// cluster-wide limit of 0 (unlimited) is bounded by the scheduler's own
+// MaxBatchSize rather than left at 0.
+func TestLimitsConfigDefaultFallsBackToSchedulerMaxBatchSize(t *testing.T) {
+	l := LimitsConfig{MaxBatchSize: 0, TierCeiling: 3}
+	got := l.Default(8)
+	if got.MaxBatchSize != 8 {
+		t.Errorf("Default(8).MaxBatchSize = %d, want 8 (scheduler fallback)", got.MaxBatchSize)
+	}
+
+	l.MaxBatchSize = 4
+	got = l.Default(8)
+	if got.MaxBatchSize != 4 {
+		t.Errorf("Default(8).MaxBatchSize = %d, want 4 (explicit limit, no fallback)", got.MaxBatchSize)
+	}
+}
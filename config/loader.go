@@ -0,0 +1,185 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFilePath points Load at a YAML/JSON config file, set via
+// SetConfigFilePath. When unset, Load falls back to the
+// GOINFER_CONFIG_FILE environment variable.
+var configFilePath string
+
+// SetConfigFilePath overrides the path Load reads its optional YAML/JSON
+// config file from. Call it before Load (or before constructing a
+// ConfigState) if your binary exposes a --config.file-style flag of its
+// own; the loader has no opinion on which flag/CLI library you use and
+// never inspects os.Args itself.
+func SetConfigFilePath(path string) { configFilePath = path }
+
+// Provider is a source of raw config values keyed by the string used in a
+// field's `env` or `yaml` struct tag. Load itself only queries the builtin
+// file and env providers (see defaultSources); this package has no
+// exported hook for adding another Provider to Load.
+type Provider interface {
+	Get(key string) (string, bool)
+}
+
+// source pairs a Provider with the struct tag it should be queried under,
+// e.g. the file provider is looked up by `yaml` tag, the env provider by
+// `env` tag.
+type source struct {
+	tag      string
+	provider Provider
+}
+
+// defaultSources returns the loader's builtin (file, env) sources in
+// priority order (later entries override earlier ones).
+func defaultSources() []source {
+	path := configFilePath
+	if path == "" {
+		path = os.Getenv("GOINFER_CONFIG_FILE")
+	}
+	fileProv, err := newFileProvider(path)
+	if err != nil {
+		logf("config: %v", err)
+		fileProv = &fileProvider{}
+	}
+	return []source{
+		{tag: "yaml", provider: fileProv},
+		{tag: "env", provider: envProvider{}},
+	}
+}
+
+// envProvider reads values straight from the process environment.
+type envProvider struct{}
+
+func (envProvider) Get(key string) (string, bool) { return os.LookupEnv(key) }
+
+// fileProvider serves values parsed out of a flat YAML/JSON document, e.g.
+//
+//	planner_mode: hybrid
+//	max_batch_size: 16
+type fileProvider struct {
+	values map[string]string
+}
+
+func newFileProvider(path string) (*fileProvider, error) {
+	if path == "" {
+		return &fileProvider{}, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file %q: %w", path, err)
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parsing config file %q: %w", path, err)
+	}
+	values := make(map[string]string, len(doc))
+	for k, v := range doc {
+		values[k] = fmt.Sprintf("%v", v)
+	}
+	return &fileProvider{values: values}, nil
+}
+
+func (f *fileProvider) Get(key string) (string, bool) {
+	v, ok := f.values[key]
+	return v, ok
+}
+
+// Parser converts a raw string value into a reflect.Value assignable to a
+// destination field type. Register one with RegisterParser to teach the
+// loader about types it doesn't know natively (time.Duration, []string,
+// tier weight maps, ...).
+type Parser func(raw string) (reflect.Value, error)
+
+var parsers = map[reflect.Type]Parser{
+	reflect.TypeOf(""): func(raw string) (reflect.Value, error) {
+		return reflect.ValueOf(raw), nil
+	},
+	reflect.TypeOf(0): func(raw string) (reflect.Value, error) {
+		i, err := strconv.Atoi(raw)
+		return reflect.ValueOf(i), err
+	},
+	reflect.TypeOf(float64(0)): func(raw string) (reflect.Value, error) {
+		f, err := strconv.ParseFloat(raw, 64)
+		return reflect.ValueOf(f), err
+	},
+	reflect.TypeOf(false): func(raw string) (reflect.Value, error) {
+		b, err := strconv.ParseBool(raw)
+		return reflect.ValueOf(b), err
+	},
+}
+
+// RegisterParser adds or overrides the Parser used for fields of type t.
+// Call it during package init to extend the loader with custom field
+// types beyond the string/int/float64/bool it understands natively.
+func RegisterParser(t reflect.Type, p Parser) {
+	parsers[t] = p
+}
+
+// loadInto walks dst's fields (recursing into embedded/nested structs),
+// applying `default` tags first and then any source that has a value for
+// the field's `yaml`/`env` tag, in source order. It returns one error per
+// field whose raw value could not be parsed into the field's type; those
+// fields keep whatever value they already had.
+func loadInto(dst interface{}, sources []source) []error {
+	return loadStruct(reflect.ValueOf(dst).Elem(), sources)
+}
+
+func loadStruct(v reflect.Value, sources []source) []error {
+	var errs []error
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct {
+			errs = append(errs, loadStruct(fv, sources)...)
+			continue
+		}
+		if def, ok := field.Tag.Lookup("default"); ok {
+			if parsed, err := parseValue(fv.Type(), def); err == nil {
+				fv.Set(parsed)
+			}
+		}
+		for _, src := range sources {
+			key := field.Tag.Get(src.tag)
+			if key == "" {
+				continue
+			}
+			raw, ok := src.provider.Get(key)
+			if !ok {
+				continue
+			}
+			parsed, err := parseValue(fv.Type(), raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s=%q: %w", src.tag, key, raw, err))
+				continue
+			}
+			fv.Set(parsed)
+		}
+	}
+	return errs
+}
+
+func parseValue(t reflect.Type, raw string) (reflect.Value, error) {
+	parse, ok := parsers[t]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("no parser registered for type %s", t)
+	}
+	v, err := parse(raw)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return v.Convert(t), nil
+}
+
+func logf(format string, args ...interface{}) {
+	log.Printf(format, args...)
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadPrecedence checks that file values override defaults and env
+// values override both, per field, matching the order documented on Load.
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name  string
+		yaml  string
+		env   map[string]string
+		check func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "default only",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Scheduler.MaxBatchSize != 8 {
+					t.Errorf("MaxBatchSize = %d, want default 8", cfg.Scheduler.MaxBatchSize)
+				}
+			},
+		},
+		{
+			name: "file overrides default",
+			yaml: "max_batch_size: 16\n",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Scheduler.MaxBatchSize != 16 {
+					t.Errorf("MaxBatchSize = %d, want file value 16", cfg.Scheduler.MaxBatchSize)
+				}
+			},
+		},
+		{
+			name: "env overrides file",
+			yaml: "max_batch_size: 16\n",
+			env:  map[string]string{"MAX_BATCH_SIZE": "32"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Scheduler.MaxBatchSize != 32 {
+					t.Errorf("MaxBatchSize = %d, want env value 32", cfg.Scheduler.MaxBatchSize)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resetConfigFilePath(t)
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			if tc.yaml != "" {
+				dir := t.TempDir()
+				path := filepath.Join(dir, "goinfer.yaml")
+				if err := os.WriteFile(path, []byte(tc.yaml), 0o644); err != nil {
+					t.Fatalf("writing temp config file: %v", err)
+				}
+				SetConfigFilePath(path)
+			}
+			tc.check(t, Load())
+		})
+	}
+}
+
+// resetConfigFilePath clears any config file path set by a previous
+// subtest so tests don't leak state into one another.
+func resetConfigFilePath(t *testing.T) {
+	t.Helper()
+	SetConfigFilePath("")
+	t.Cleanup(func() { SetConfigFilePath("") })
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func init() {
+	RegisterParser(reflect.TypeOf(RedisMode("")), func(raw string) (reflect.Value, error) {
+		return reflect.ValueOf(RedisMode(raw)), nil
+	})
+	RegisterParser(reflect.TypeOf([]string(nil)), func(raw string) (reflect.Value, error) {
+		if raw == "" {
+			return reflect.ValueOf([]string(nil)), nil
+		}
+		parts := strings.Split(raw, ",")
+		for i, p := range parts {
+			parts[i] = strings.TrimSpace(p)
+		}
+		return reflect.ValueOf(parts), nil
+	})
+}
+
+// RedisMode selects how RedisConfig.NewClient talks to Redis.
+type RedisMode string
+
+const (
+	RedisModeSingle   RedisMode = "single"
+	RedisModeSentinel RedisMode = "sentinel"
+	RedisModeCluster  RedisMode = "cluster"
+)
+
+// RedisConfig describes how to reach the scheduler's queue/state store.
+// Mode picks which of the single/sentinel/cluster field groups below is
+// used; the rest are ignored.
+type RedisConfig struct {
+	Mode RedisMode `yaml:"mode" env:"REDIS_MODE" default:"single"` // "single" | "sentinel" | "cluster"
+
+	Addr string `yaml:"addr" env:"REDIS_ADDR" default:"localhost:6379"` // single mode only
+
+	SentinelAddrs    []string `yaml:"sentinel_addrs" env:"REDIS_SENTINEL_ADDRS" default:""`       // sentinel mode only, comma-separated
+	MasterName       string   `yaml:"master_name" env:"REDIS_MASTER_NAME" default:""`             // sentinel mode only
+	SentinelPassword string   `yaml:"sentinel_password" env:"REDIS_SENTINEL_PASSWORD" default:""` // sentinel mode only
+
+	ClusterAddrs []string `yaml:"cluster_addrs" env:"REDIS_CLUSTER_ADDRS" default:""` // cluster mode only, comma-separated
+
+	DB       int    `yaml:"db" env:"REDIS_DB" default:"0"`            // single/sentinel mode only, ignored in cluster mode
+	Username string `yaml:"username" env:"REDIS_USERNAME" default:""` // ACL username
+	Password string `yaml:"password" env:"REDIS_PASSWORD" default:""` // empty string = no auth
+
+	TLS TLSConfig
+
+	PoolSize       int `yaml:"pool_size" env:"REDIS_POOL_SIZE" default:"0"`               // 0 = go-redis default
+	MinIdleConns   int `yaml:"min_idle_conns" env:"REDIS_MIN_IDLE_CONNS" default:"0"`     // 0 = go-redis default
+	DialTimeoutMs  int `yaml:"dial_timeout_ms" env:"REDIS_DIAL_TIMEOUT_MS" default:"0"`   // 0 = go-redis default
+	ReadTimeoutMs  int `yaml:"read_timeout_ms" env:"REDIS_READ_TIMEOUT_MS" default:"0"`   // 0 = go-redis default
+	WriteTimeoutMs int `yaml:"write_timeout_ms" env:"REDIS_WRITE_TIMEOUT_MS" default:"0"` // 0 = go-redis default
+	MaxRetries     int `yaml:"max_retries" env:"REDIS_MAX_RETRIES" default:"0"`           // 0 = go-redis default
+}
+
+// TLSConfig configures TLS for the Redis connection. It is unused unless
+// Enabled is true, so plaintext deployments don't need to set anything.
+type TLSConfig struct {
+	Enabled            bool   `yaml:"enabled" env:"REDIS_TLS_ENABLED" default:"false"`
+	CAFile             string `yaml:"ca_file" env:"REDIS_TLS_CA_FILE" default:""`
+	CertFile           string `yaml:"cert_file" env:"REDIS_TLS_CERT_FILE" default:""`
+	KeyFile            string `yaml:"key_file" env:"REDIS_TLS_KEY_FILE" default:""`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify" env:"REDIS_TLS_INSECURE_SKIP_VERIFY" default:"false"`
+}
+
+// tlsConfig builds a *tls.Config from t, or returns nil if TLS isn't
+// enabled.
+func (t TLSConfig) tlsConfig() (*tls.Config, error) {
+	if !t.Enabled {
+		return nil, nil
+	}
+	cfg := &tls.Config{InsecureSkipVerify: t.InsecureSkipVerify}
+	if t.CAFile != "" {
+		ca, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("config: reading redis TLS CA file %q: %w", t.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("config: no certificates found in redis TLS CA file %q", t.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("config: loading redis TLS client cert/key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// NewClient returns the go-redis client appropriate for r.Mode: a plain
+// *redis.Client in single mode, a failover-aware client in sentinel
+// mode, or a *redis.ClusterClient in cluster mode. All three satisfy
+// redis.UniversalClient so callers don't need to switch on Mode
+// themselves.
+func (r RedisConfig) NewClient() (redis.UniversalClient, error) {
+	tlsCfg, err := r.TLS.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.Mode {
+	case "", RedisModeSingle:
+		return redis.NewClient(&redis.Options{
+			Addr:         r.Addr,
+			Username:     r.Username,
+			Password:     r.Password,
+			DB:           r.DB,
+			PoolSize:     r.PoolSize,
+			MinIdleConns: r.MinIdleConns,
+			DialTimeout:  millis(r.DialTimeoutMs),
+			ReadTimeout:  millis(r.ReadTimeoutMs),
+			WriteTimeout: millis(r.WriteTimeoutMs),
+			MaxRetries:   r.MaxRetries,
+			TLSConfig:    tlsCfg,
+		}), nil
+	case RedisModeSentinel:
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       r.MasterName,
+			SentinelAddrs:    r.SentinelAddrs,
+			SentinelPassword: r.SentinelPassword,
+			Username:         r.Username,
+			Password:         r.Password,
+			DB:               r.DB,
+			PoolSize:         r.PoolSize,
+			MinIdleConns:     r.MinIdleConns,
+			DialTimeout:      millis(r.DialTimeoutMs),
+			ReadTimeout:      millis(r.ReadTimeoutMs),
+			WriteTimeout:     millis(r.WriteTimeoutMs),
+			MaxRetries:       r.MaxRetries,
+			TLSConfig:        tlsCfg,
+		}), nil
+	case RedisModeCluster:
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        r.ClusterAddrs,
+			Username:     r.Username,
+			Password:     r.Password,
+			PoolSize:     r.PoolSize,
+			MinIdleConns: r.MinIdleConns,
+			DialTimeout:  millis(r.DialTimeoutMs),
+			ReadTimeout:  millis(r.ReadTimeoutMs),
+			WriteTimeout: millis(r.WriteTimeoutMs),
+			MaxRetries:   r.MaxRetries,
+			TLSConfig:    tlsCfg,
+		}), nil
+	default:
+		return nil, fmt.Errorf("config: unknown redis mode %q", r.Mode)
+	}
+}
+
+// millis converts a millisecond count to a time.Duration, leaving 0 as 0
+// so go-redis applies its own default instead of a zero timeout.
+func millis(ms int) time.Duration {
+	if ms == 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
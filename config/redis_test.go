@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TestRedisConfigEnvParsing checks that REDIS_MODE and the comma-separated
+// address lists parse through Load as documented.
+func TestRedisConfigEnvParsing(t *testing.T) {
+	resetConfigFilePath(t)
+
+	t.Setenv("REDIS_MODE", "cluster")
+	t.Setenv("REDIS_CLUSTER_ADDRS", "10.0.0.1:6379, 10.0.0.2:6379,10.0.0.3:6379")
+	cfg := Load()
+
+	if cfg.Redis.Mode != RedisModeCluster {
+		t.Errorf("Redis.Mode = %q, want %q", cfg.Redis.Mode, RedisModeCluster)
+	}
+	want := []string{"10.0.0.1:6379", "10.0.0.2:6379", "10.0.0.3:6379"}
+	if len(cfg.Redis.ClusterAddrs) != len(want) {
+		t.Fatalf("Redis.ClusterAddrs = %v, want %v", cfg.Redis.ClusterAddrs, want)
+	}
+	for i, addr := range want {
+		if cfg.Redis.ClusterAddrs[i] != addr {
+			t.Errorf("Redis.ClusterAddrs[%d] = %q, want %q", i, cfg.Redis.ClusterAddrs[i], addr)
+		}
+	}
+}
+
+// TestRedisConfigSentinelEnvParsing checks REDIS_SENTINEL_ADDRS parsing
+// alongside the other sentinel-only fields.
+func TestRedisConfigSentinelEnvParsing(t *testing.T) {
+	resetConfigFilePath(t)
+
+	t.Setenv("REDIS_MODE", "sentinel")
+	t.Setenv("REDIS_SENTINEL_ADDRS", "10.0.0.1:26379,10.0.0.2:26379")
+	t.Setenv("REDIS_MASTER_NAME", "mymaster")
+	cfg := Load()
+
+	if cfg.Redis.Mode != RedisModeSentinel {
+		t.Errorf("Redis.Mode = %q, want %q", cfg.Redis.Mode, RedisModeSentinel)
+	}
+	want := []string{"10.0.0.1:26379", "10.0.0.2:26379"}
+	if len(cfg.Redis.SentinelAddrs) != len(want) {
+		t.Fatalf("Redis.SentinelAddrs = %v, want %v", cfg.Redis.SentinelAddrs, want)
+	}
+	for i, addr := range want {
+		if cfg.Redis.SentinelAddrs[i] != addr {
+			t.Errorf("Redis.SentinelAddrs[%d] = %q, want %q", i, cfg.Redis.SentinelAddrs[i], addr)
+		}
+	}
+	if cfg.Redis.MasterName != "mymaster" {
+		t.Errorf("Redis.MasterName = %q, want %q", cfg.Redis.MasterName, "mymaster")
+	}
+}
+
+// TestRedisConfigNewClient checks that NewClient returns the concrete
+// go-redis client type matching Mode, for each of the three modes.
+func TestRedisConfigNewClient(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  RedisConfig
+		want interface{}
+	}{
+		{
+			name: "single",
+			cfg:  RedisConfig{Mode: RedisModeSingle, Addr: "localhost:6379"},
+			want: &redis.Client{},
+		},
+		{
+			name: "sentinel",
+			cfg:  RedisConfig{Mode: RedisModeSentinel, SentinelAddrs: []string{"localhost:26379"}, MasterName: "mymaster"},
+			want: &redis.Client{}, // NewFailoverClient also returns *redis.Client
+		},
+		{
+			name: "cluster",
+			cfg:  RedisConfig{Mode: RedisModeCluster, ClusterAddrs: []string{"localhost:7000"}},
+			want: &redis.ClusterClient{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := tc.cfg.NewClient()
+			if err != nil {
+				t.Fatalf("NewClient() returned an error: %v", err)
+			}
+			if client == nil {
+				t.Fatal("NewClient() returned a nil client")
+			}
+			gotType := fmt.Sprintf("%T", client)
+			wantType := fmt.Sprintf("%T", tc.want)
+			if gotType != wantType {
+				t.Errorf("NewClient() returned %s, want %s", gotType, wantType)
+			}
+		})
+	}
+}
+
+// TestRedisConfigNewClientUnknownMode checks that an unrecognized Mode is
+// rejected rather than silently falling back to single mode.
+func TestRedisConfigNewClientUnknownMode(t *testing.T) {
+	_, err := RedisConfig{Mode: "bogus"}.NewClient()
+	if err == nil {
+		t.Fatal("NewClient() with an unknown mode returned no error")
+	}
+}
+
+// TestTLSConfigErrors checks the two file-loading error paths: a CAFile
+// that doesn't contain a valid certificate, and a CertFile set without its
+// matching KeyFile.
+func TestTLSConfigErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	badCA := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(badCA, []byte("not a certificate"), 0o644); err != nil {
+		t.Fatalf("writing bad CA file: %v", err)
+	}
+	_, err := TLSConfig{Enabled: true, CAFile: badCA}.tlsConfig()
+	if err == nil {
+		t.Fatal("tlsConfig() with a CA file containing no certificates returned no error")
+	}
+
+	certOnly := TLSConfig{Enabled: true, CertFile: filepath.Join(dir, "cert.pem")}
+	if _, err := certOnly.tlsConfig(); err == nil {
+		t.Fatal("tlsConfig() with a CertFile but no KeyFile returned no error")
+	}
+
+	if cfg, err := (TLSConfig{Enabled: false}).tlsConfig(); err != nil || cfg != nil {
+		t.Errorf("tlsConfig() with Enabled=false = (%v, %v), want (nil, nil)", cfg, err)
+	}
+}
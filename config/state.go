@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync/atomic"
+	"syscall"
+)
+
+// ConfigState wraps a *Config behind an atomic pointer so callers on any
+// goroutine can grab a consistent snapshot without locking, while a
+// background reloader swaps in a freshly loaded config in response to
+// SIGHUP.
+type ConfigState struct {
+	ptr          atomic.Pointer[Config]
+	overridesPtr atomic.Pointer[TenantOverrides]
+}
+
+// NewConfigState loads an initial config (and its tenant overrides file,
+// if configured) and returns a ConfigState ready to serve Snapshot calls.
+func NewConfigState() *ConfigState {
+	s := &ConfigState{}
+	cfg := Load()
+	s.ptr.Store(cfg)
+	overrides, err := LoadTenantOverrides(cfg.Scheduler.Limits.OverridesFile, cfg.Scheduler.Limits.Default(cfg.Scheduler.MaxBatchSize))
+	if err != nil {
+		logf("config: %v", err)
+		overrides = &TenantOverrides{def: cfg.Scheduler.Limits.Default(cfg.Scheduler.MaxBatchSize)}
+	}
+	s.overridesPtr.Store(overrides)
+	return s
+}
+
+// Snapshot returns the currently active Config. The scheduler, planner,
+// and node-agent components should call this on every decision rather
+// than holding onto a Config for longer than a single decision, so a
+// reload takes effect immediately.
+func (s *ConfigState) Snapshot() *Config {
+	return s.ptr.Load()
+}
+
+// TenantLimits returns the effective TenantLimits for tenant, honoring
+// whatever overrides file was most recently reloaded.
+func (s *ConfigState) TenantLimits(tenant string) TenantLimits {
+	return s.overridesPtr.Load().Limits(tenant)
+}
+
+// Reload re-reads env/file sources and the tenant overrides file,
+// validates the result, and only then swaps both in, each with its own
+// atomic store (config first, then overrides), so a concurrent reader
+// may briefly see the new config alongside the previous overrides. A
+// failed validation leaves the previously active config and overrides
+// untouched.
+func (s *ConfigState) Reload() error {
+	next := Load()
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	overrides, err := LoadTenantOverrides(next.Scheduler.Limits.OverridesFile, next.Scheduler.Limits.Default(next.Scheduler.MaxBatchSize))
+	if err != nil {
+		return err
+	}
+	prev := s.ptr.Swap(next)
+	s.overridesPtr.Store(overrides)
+	logChangedKeys(prev, next)
+	return nil
+}
+
+// Reset reloads from scratch, bypassing whatever is currently active.
+// Unlike Reload it does not preserve the previous config on validation
+// failure, since callers use Reset when they want a clean slate
+// regardless (e.g. in tests).
+func (s *ConfigState) Reset() {
+	cfg := Load()
+	s.ptr.Store(cfg)
+	overrides, err := LoadTenantOverrides(cfg.Scheduler.Limits.OverridesFile, cfg.Scheduler.Limits.Default(cfg.Scheduler.MaxBatchSize))
+	if err != nil {
+		logf("config: %v", err)
+		overrides = &TenantOverrides{def: cfg.Scheduler.Limits.Default(cfg.Scheduler.MaxBatchSize)}
+	}
+	s.overridesPtr.Store(overrides)
+}
+
+// WatchSIGHUP installs a signal handler that calls Reload on every
+// SIGHUP received, logging the outcome. It returns a stop function that
+// tears down the handler.
+func (s *ConfigState) WatchSIGHUP() (stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				if err := s.Reload(); err != nil {
+					logf("config: SIGHUP reload rejected: %v", err)
+				} else {
+					logf("config: reloaded via SIGHUP")
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+	}
+}
+
+// ReloadHandler returns an http.HandlerFunc suitable for mounting at
+// POST /-/reload on the gateway: it calls Reload and responds 200 on
+// success or 400 with the validation error otherwise.
+func (s *ConfigState) ReloadHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := s.Reload(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// logChangedKeys emits a structured log line per leaf field (nested
+// structs included) that changed between prev and next, so operators can see exactly what a
+// reload did without diffing two full configs by hand.
+func logChangedKeys(prev, next *Config) {
+	for _, d := range diffFields(prev, next) {
+		logf("config: reload changed %s: %q -> %q", d.field, d.oldValue, d.newValue)
+	}
+}
+
+type fieldDiff struct {
+	field    string
+	oldValue string
+	newValue string
+}
+
+func diffFields(prev, next *Config) []fieldDiff {
+	var diffs []fieldDiff
+	diffStruct(reflect.ValueOf(*prev), reflect.ValueOf(*next), &diffs)
+	return diffs
+}
+
+// diffStruct walks two same-typed Config structs leaf by leaf, keyed by
+// each field's `env` tag (falling back to its Go name), and records a
+// fieldDiff for every leaf whose formatted value changed.
+func diffStruct(prev, next reflect.Value, diffs *[]fieldDiff) {
+	t := prev.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		pf, nf := prev.Field(i), next.Field(i)
+		if pf.Kind() == reflect.Struct {
+			diffStruct(pf, nf, diffs)
+			continue
+		}
+		key := field.Tag.Get("env")
+		if key == "" {
+			key = field.Name
+		}
+		pv := fmt.Sprintf("%v", pf.Interface())
+		nv := fmt.Sprintf("%v", nf.Interface())
+		if pv != nv {
+			*diffs = append(*diffs, fieldDiff{field: key, oldValue: pv, newValue: nv})
+		}
+	}
+}
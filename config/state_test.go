@@ -0,0 +1,63 @@
+package config
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestConfigStateReload checks that Reload swaps in a validated config and
+// rejects one that fails Validate, leaving the previously active config in
+// place.
+func TestConfigStateReload(t *testing.T) {
+	resetConfigFilePath(t)
+	s := NewConfigState()
+	before := s.Snapshot()
+
+	t.Setenv("MAX_BATCH_SIZE", "0") // Validate requires >= 1
+	if err := s.Reload(); err == nil {
+		t.Fatal("Reload with an invalid MaxBatchSize returned no error")
+	}
+	if got := s.Snapshot(); got != before {
+		t.Fatal("Reload on validation failure replaced the active config")
+	}
+
+	t.Setenv("MAX_BATCH_SIZE", "16")
+	if err := s.Reload(); err != nil {
+		t.Fatalf("Reload with a valid config returned an error: %v", err)
+	}
+	if got := s.Snapshot().Scheduler.MaxBatchSize; got != 16 {
+		t.Fatalf("Snapshot().Scheduler.MaxBatchSize = %d, want 16", got)
+	}
+}
+
+// TestConfigStateSnapshotDuringReload exercises concurrent Snapshot and
+// Reload calls under the race detector: every Snapshot must return a
+// complete, never-partially-written *Config.
+func TestConfigStateSnapshotDuringReload(t *testing.T) {
+	resetConfigFilePath(t)
+	s := NewConfigState()
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = s.Reload()
+			}
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		if cfg := s.Snapshot(); cfg.Scheduler.MaxBatchSize < 1 {
+			t.Errorf("Snapshot returned an invalid MaxBatchSize %d mid-reload", cfg.Scheduler.MaxBatchSize)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
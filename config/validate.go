@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// FieldError describes a single invalid field: which one, what value it
+// held, and why that value was rejected.
+type FieldError struct {
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("config: %s=%q: %s", e.Field, e.Value, e.Reason)
+}
+
+// ValidationError aggregates every problem found by Validate, rather
+// than stopping at the first one, so an operator fixing a bad config
+// file (or a rejected SIGHUP reload) sees the whole list in one pass.
+type ValidationError struct {
+	Problems []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validate performs sanity checks on the scheduler tuning knobs, the
+// Redis connection settings, and the Postgres DSN, so a reload (or
+// startup) can reject an obviously broken config before it gets swapped
+// in. It collects every problem it finds and returns them together as a
+// *ValidationError (nil if none), so a single bad edit doesn't hide the
+// next one.
+func (c *Config) Validate() error {
+	var problems []FieldError
+	add := func(field, value, reason string) {
+		problems = append(problems, FieldError{Field: field, Value: value, Reason: reason})
+	}
+
+	s := c.Scheduler
+	switch s.PlannerMode {
+	case "heuristic", "llm", "hybrid":
+	default:
+		add("scheduler.planner_mode", s.PlannerMode, `must be one of "heuristic", "llm", "hybrid"`)
+	}
+	if s.VRAMSafetyMargin < 0 || s.VRAMSafetyMargin > 0.9 {
+		add("scheduler.vram_safety_margin", fmt.Sprintf("%v", s.VRAMSafetyMargin), "must be in [0.0, 0.9]")
+	}
+	if s.MaxBatchSize < 1 {
+		add("scheduler.max_batch_size", fmt.Sprintf("%d", s.MaxBatchSize), "must be >= 1")
+	}
+	if s.FlushDeadlineMs <= 0 {
+		add("scheduler.flush_deadline_ms", fmt.Sprintf("%d", s.FlushDeadlineMs), "must be > 0")
+	} else if s.FlushDeadlineMs <= s.PlannerTimeoutMs {
+		add("scheduler.flush_deadline_ms", fmt.Sprintf("%d", s.FlushDeadlineMs), fmt.Sprintf("must be > scheduler.planner_timeout_ms (%d)", s.PlannerTimeoutMs))
+	}
+	if s.AgingThresholdS < 1 {
+		add("scheduler.aging_threshold_s", fmt.Sprintf("%d", s.AgingThresholdS), "must be >= 1")
+	}
+	if s.HealthIntervalMs < 50 || s.HealthIntervalMs > 60000 {
+		add("scheduler.health_interval_ms", fmt.Sprintf("%d", s.HealthIntervalMs), "must be in [50, 60000]")
+	}
+	switch s.Limits.LimiterStrategy {
+	case LimiterStrategyLocal, LimiterStrategyGlobal:
+	default:
+		add("scheduler.limits.limiter_strategy", string(s.Limits.LimiterStrategy), `must be one of "local", "global"`)
+	}
+	if s.Limits.TierCeiling < 0 {
+		add("scheduler.limits.tier_ceiling", fmt.Sprintf("%d", s.Limits.TierCeiling), "must be >= 0")
+	}
+
+	switch c.Redis.Mode {
+	case "", RedisModeSingle:
+		if _, _, err := net.SplitHostPort(c.Redis.Addr); err != nil {
+			add("redis.addr", c.Redis.Addr, "must be a host:port address")
+		}
+	case RedisModeSentinel:
+		if len(c.Redis.SentinelAddrs) == 0 {
+			add("redis.sentinel_addrs", "", "must be set when redis.mode is \"sentinel\"")
+		}
+		if c.Redis.MasterName == "" {
+			add("redis.master_name", "", "must be set when redis.mode is \"sentinel\"")
+		}
+	case RedisModeCluster:
+		if len(c.Redis.ClusterAddrs) == 0 {
+			add("redis.cluster_addrs", "", "must be set when redis.mode is \"cluster\"")
+		}
+	default:
+		add("redis.mode", string(c.Redis.Mode), `must be one of "single", "sentinel", "cluster"`)
+	}
+
+	if _, err := pgx.ParseConfig(c.Postgres.DSN); err != nil {
+		add("postgres.dsn", c.Postgres.DSN, fmt.Sprintf("must be a valid postgres connection string: %v", err))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: problems}
+}
+
+// LoadAndValidate is Load followed by Validate, so main can fail fast on
+// a bad config instead of the scheduler crashing later with a stack
+// trace deep inside a request path.
+func LoadAndValidate() (*Config, error) {
+	cfg := Load()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,228 @@
+package config
+
+import "testing"
+
+// validConfig returns a Config that passes Validate, so each test can
+// mutate a single field and check that exactly that rule fires.
+func validConfig(t *testing.T) *Config {
+	t.Helper()
+	resetConfigFilePath(t)
+	cfg := Load()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("default config failed to validate: %v", err)
+	}
+	return cfg
+}
+
+// fieldsOf returns the set of FieldError.Field values present in err,
+// which must be a *ValidationError.
+func fieldsOf(t *testing.T, err error) map[string]bool {
+	t.Helper()
+	if err == nil {
+		t.Fatal("Validate returned nil, want a *ValidationError")
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate returned %T, want *ValidationError", err)
+	}
+	fields := make(map[string]bool, len(verr.Problems))
+	for _, p := range verr.Problems {
+		fields[p.Field] = true
+	}
+	return fields
+}
+
+// TestValidateSchedulerRules checks each independent Scheduler rule in
+// isolation: exactly that field should be flagged.
+func TestValidateSchedulerRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *Config)
+		field  string
+	}{
+		{
+			name:   "planner mode not in enum",
+			mutate: func(cfg *Config) { cfg.Scheduler.PlannerMode = "bogus" },
+			field:  "scheduler.planner_mode",
+		},
+		{
+			name:   "vram safety margin below range",
+			mutate: func(cfg *Config) { cfg.Scheduler.VRAMSafetyMargin = -0.1 },
+			field:  "scheduler.vram_safety_margin",
+		},
+		{
+			name:   "vram safety margin above range",
+			mutate: func(cfg *Config) { cfg.Scheduler.VRAMSafetyMargin = 0.91 },
+			field:  "scheduler.vram_safety_margin",
+		},
+		{
+			name:   "max batch size below minimum",
+			mutate: func(cfg *Config) { cfg.Scheduler.MaxBatchSize = 0 },
+			field:  "scheduler.max_batch_size",
+		},
+		{
+			name:   "flush deadline not positive",
+			mutate: func(cfg *Config) { cfg.Scheduler.FlushDeadlineMs = 0 },
+			field:  "scheduler.flush_deadline_ms",
+		},
+		{
+			name: "flush deadline not after planner timeout",
+			mutate: func(cfg *Config) {
+				cfg.Scheduler.PlannerTimeoutMs = 100
+				cfg.Scheduler.FlushDeadlineMs = 100
+			},
+			field: "scheduler.flush_deadline_ms",
+		},
+		{
+			name:   "aging threshold below minimum",
+			mutate: func(cfg *Config) { cfg.Scheduler.AgingThresholdS = 0 },
+			field:  "scheduler.aging_threshold_s",
+		},
+		{
+			name:   "health interval below range",
+			mutate: func(cfg *Config) { cfg.Scheduler.HealthIntervalMs = 49 },
+			field:  "scheduler.health_interval_ms",
+		},
+		{
+			name:   "health interval above range",
+			mutate: func(cfg *Config) { cfg.Scheduler.HealthIntervalMs = 60001 },
+			field:  "scheduler.health_interval_ms",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig(t)
+			tc.mutate(cfg)
+			fields := fieldsOf(t, cfg.Validate())
+			if !fields[tc.field] {
+				t.Errorf("Validate did not flag %s; got fields %v", tc.field, fields)
+			}
+		})
+	}
+}
+
+// TestValidateRedisRules checks the per-Mode Redis branches.
+func TestValidateRedisRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *Config)
+		field  string
+	}{
+		{
+			name:   "single mode bad addr",
+			mutate: func(cfg *Config) { cfg.Redis.Mode = RedisModeSingle; cfg.Redis.Addr = "not-a-host-port" },
+			field:  "redis.addr",
+		},
+		{
+			name: "sentinel mode missing sentinel addrs",
+			mutate: func(cfg *Config) {
+				cfg.Redis.Mode = RedisModeSentinel
+				cfg.Redis.SentinelAddrs = nil
+				cfg.Redis.MasterName = "mymaster"
+			},
+			field: "redis.sentinel_addrs",
+		},
+		{
+			name: "sentinel mode missing master name",
+			mutate: func(cfg *Config) {
+				cfg.Redis.Mode = RedisModeSentinel
+				cfg.Redis.SentinelAddrs = []string{"localhost:26379"}
+				cfg.Redis.MasterName = ""
+			},
+			field: "redis.master_name",
+		},
+		{
+			name: "cluster mode missing cluster addrs",
+			mutate: func(cfg *Config) {
+				cfg.Redis.Mode = RedisModeCluster
+				cfg.Redis.ClusterAddrs = nil
+			},
+			field: "redis.cluster_addrs",
+		},
+		{
+			name:   "unknown mode",
+			mutate: func(cfg *Config) { cfg.Redis.Mode = "bogus" },
+			field:  "redis.mode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig(t)
+			tc.mutate(cfg)
+			fields := fieldsOf(t, cfg.Validate())
+			if !fields[tc.field] {
+				t.Errorf("Validate did not flag %s; got fields %v", tc.field, fields)
+			}
+		})
+	}
+}
+
+// TestValidatePostgresDSN checks that an unparseable DSN is flagged.
+func TestValidatePostgresDSN(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.Postgres.DSN = "not a postgres dsn"
+	fields := fieldsOf(t, cfg.Validate())
+	if !fields["postgres.dsn"] {
+		t.Errorf("Validate did not flag postgres.dsn; got fields %v", fields)
+	}
+}
+
+// TestValidateAggregatesAllProblems checks that Validate collects every
+// problem in one pass rather than stopping at the first.
+func TestValidateAggregatesAllProblems(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.Scheduler.PlannerMode = "bogus"
+	cfg.Scheduler.MaxBatchSize = 0
+	cfg.Postgres.DSN = "not a postgres dsn"
+
+	fields := fieldsOf(t, cfg.Validate())
+	for _, want := range []string{"scheduler.planner_mode", "scheduler.max_batch_size", "postgres.dsn"} {
+		if !fields[want] {
+			t.Errorf("Validate did not flag %s alongside the other problems; got fields %v", want, fields)
+		}
+	}
+	if len(fields) != 3 {
+		t.Errorf("Validate reported %d distinct fields, want 3: %v", len(fields), fields)
+	}
+}
+
+// TestValidateLimits checks that Validate catches a bogus LimiterStrategy
+// and a negative TierCeiling, the same class of enum/range checks already
+// applied to PlannerMode and VRAMSafetyMargin.
+func TestValidateLimits(t *testing.T) {
+	resetConfigFilePath(t)
+
+	cfg := Load()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("default config failed to validate: %v", err)
+	}
+
+	cfg.Scheduler.Limits.LimiterStrategy = "bogus"
+	cfg.Scheduler.Limits.TierCeiling = -1
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate returned nil for an invalid LimiterStrategy and TierCeiling")
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate returned %T, want *ValidationError", err)
+	}
+
+	var sawStrategy, sawCeiling bool
+	for _, p := range verr.Problems {
+		switch p.Field {
+		case "scheduler.limits.limiter_strategy":
+			sawStrategy = true
+		case "scheduler.limits.tier_ceiling":
+			sawCeiling = true
+		}
+	}
+	if !sawStrategy {
+		t.Error("Validate did not flag the invalid limiter_strategy")
+	}
+	if !sawCeiling {
+		t.Error("Validate did not flag the negative tier_ceiling")
+	}
+}